@@ -200,6 +200,89 @@ func statefulSetMetricFamilies(allowLabelsList []string, allowAnnotationsList []
 				}
 			}),
 		),
+		*generator.NewFamilyGenerator(
+			"kube_statefulset_status_update_strategy",
+			"StatefulSet update strategy.",
+			metric.Gauge,
+			"",
+			wrapStatefulSetFunc(func(s *v1.StatefulSet) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   []string{"strategy"},
+							LabelValues: []string{string(s.Spec.UpdateStrategy.Type)},
+							Value:       1,
+						},
+					},
+				}
+			}),
+		),
+		*generator.NewFamilyGenerator(
+			"kube_statefulset_rolling_update_partition",
+			"The ordinal at which the StatefulSet is partitioned for a rolling update.",
+			metric.Gauge,
+			"",
+			wrapStatefulSetFunc(func(s *v1.StatefulSet) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if s.Spec.UpdateStrategy.RollingUpdate != nil && s.Spec.UpdateStrategy.RollingUpdate.Partition != nil {
+					ms = append(ms, &metric.Metric{
+						Value: float64(*s.Spec.UpdateStrategy.RollingUpdate.Partition),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		),
+		*generator.NewFamilyGenerator(
+			"kube_statefulset_status_condition",
+			"The current status conditions of a statefulset.",
+			metric.Gauge,
+			"",
+			wrapStatefulSetFunc(func(s *v1.StatefulSet) *metric.Family {
+				ms := make([]*metric.Metric, len(s.Status.Conditions)*len(conditionStatuses))
+
+				for i, c := range s.Status.Conditions {
+					conditionMetrics := addConditionMetrics(c.Status)
+
+					for j, m := range conditionMetrics {
+						metric := m
+						metric.LabelKeys = []string{"condition", "status"}
+						metric.LabelValues = append([]string{string(c.Type)}, metric.LabelValues...)
+						ms[i*len(conditionStatuses)+j] = metric
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		),
+		*generator.NewFamilyGenerator(
+			"kube_statefulset_condition_last_transition_time",
+			"The time of the last transition of a statefulset condition.",
+			metric.Gauge,
+			"",
+			wrapStatefulSetFunc(func(s *v1.StatefulSet) *metric.Family {
+				ms := make([]*metric.Metric, 0, len(s.Status.Conditions))
+
+				for _, c := range s.Status.Conditions {
+					if !c.LastTransitionTime.IsZero() {
+						ms = append(ms, &metric.Metric{
+							LabelKeys:   []string{"condition"},
+							LabelValues: []string{string(c.Type)},
+							Value:       float64(c.LastTransitionTime.Unix()),
+						})
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		),
 	}
 	if len(allowLabelsList) > 0 {
 		families = append(families, *generator.NewFamilyGenerator(
@@ -0,0 +1,229 @@
+/*
+Copyright 2017 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/kube-state-metrics/v2/pkg/metric"
+)
+
+// generateFamily runs the named family generator against s and fails the
+// test if no generator with that name is registered.
+func generateFamily(t *testing.T, name string, s *v1.StatefulSet) *metric.Family {
+	t.Helper()
+
+	for _, g := range statefulSetMetricFamilies(nil, nil) {
+		if g.Name == name {
+			return g.GenerateFunc(s)
+		}
+	}
+
+	t.Fatalf("no family generator registered for %s", name)
+	return nil
+}
+
+// labelValue returns the value of the first label named key on m, or "" if
+// it isn't present.
+func labelValue(m *metric.Metric, key string) string {
+	for i, k := range m.LabelKeys {
+		if k == key {
+			return m.LabelValues[i]
+		}
+	}
+	return ""
+}
+
+func TestStatefulSetStoreUpdateStrategy(t *testing.T) {
+	cases := []struct {
+		name     string
+		strategy v1.StatefulSetUpdateStrategy
+		want     string
+	}{
+		{
+			name:     "RollingUpdate",
+			strategy: v1.StatefulSetUpdateStrategy{Type: v1.RollingUpdateStatefulSetStrategyType},
+			want:     "RollingUpdate",
+		},
+		{
+			name:     "OnDelete",
+			strategy: v1.StatefulSetUpdateStrategy{Type: v1.OnDeleteStatefulSetStrategyType},
+			want:     "OnDelete",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := &v1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "ss", Namespace: "ns"},
+				Spec:       v1.StatefulSetSpec{UpdateStrategy: c.strategy},
+			}
+
+			family := generateFamily(t, "kube_statefulset_status_update_strategy", s)
+			if len(family.Metrics) != 1 {
+				t.Fatalf("expected 1 metric, got %d", len(family.Metrics))
+			}
+
+			m := family.Metrics[0]
+			if got := labelValue(m, "strategy"); got != c.want {
+				t.Errorf("expected strategy label %q, got %q", c.want, got)
+			}
+			if m.Value != 1 {
+				t.Errorf("expected value 1, got %v", m.Value)
+			}
+		})
+	}
+}
+
+func TestStatefulSetStoreRollingUpdatePartition(t *testing.T) {
+	partition := int32(3)
+
+	cases := []struct {
+		name           string
+		updateStrategy v1.StatefulSetUpdateStrategy
+		wantValue      *float64
+	}{
+		{
+			name: "partition set",
+			updateStrategy: v1.StatefulSetUpdateStrategy{
+				RollingUpdate: &v1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+			},
+			wantValue: func() *float64 { v := float64(partition); return &v }(),
+		},
+		{
+			name:           "rolling update nil",
+			updateStrategy: v1.StatefulSetUpdateStrategy{},
+			wantValue:      nil,
+		},
+		{
+			name: "partition nil",
+			updateStrategy: v1.StatefulSetUpdateStrategy{
+				RollingUpdate: &v1.RollingUpdateStatefulSetStrategy{},
+			},
+			wantValue: nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := &v1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "ss", Namespace: "ns"},
+				Spec:       v1.StatefulSetSpec{UpdateStrategy: c.updateStrategy},
+			}
+
+			family := generateFamily(t, "kube_statefulset_rolling_update_partition", s)
+
+			if c.wantValue == nil {
+				if len(family.Metrics) != 0 {
+					t.Fatalf("expected no metrics, got %d", len(family.Metrics))
+				}
+				return
+			}
+
+			if len(family.Metrics) != 1 {
+				t.Fatalf("expected 1 metric, got %d", len(family.Metrics))
+			}
+			if got := family.Metrics[0].Value; got != *c.wantValue {
+				t.Errorf("expected value %v, got %v", *c.wantValue, got)
+			}
+		})
+	}
+}
+
+func TestStatefulSetStoreStatusCondition(t *testing.T) {
+	transitionTime := metav1.NewTime(time.Unix(1500000000, 0))
+
+	s := &v1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "ss", Namespace: "ns"},
+		Status: v1.StatefulSetStatus{
+			Conditions: []v1.StatefulSetCondition{
+				{Type: "Ready", Status: corev1.ConditionTrue, LastTransitionTime: transitionTime},
+				{Type: "Progressing", Status: corev1.ConditionFalse},
+				{Type: "Degraded", Status: corev1.ConditionUnknown},
+			},
+		},
+	}
+
+	family := generateFamily(t, "kube_statefulset_status_condition", s)
+
+	wantMetrics := len(s.Status.Conditions) * len(conditionStatuses)
+	if len(family.Metrics) != wantMetrics {
+		t.Fatalf("expected %d metrics, got %d", wantMetrics, len(family.Metrics))
+	}
+
+	wantValues := map[[2]string]float64{
+		{"Ready", "True"}:          1,
+		{"Ready", "False"}:         0,
+		{"Ready", "Unknown"}:       0,
+		{"Progressing", "True"}:    0,
+		{"Progressing", "False"}:   1,
+		{"Progressing", "Unknown"}: 0,
+		{"Degraded", "True"}:       0,
+		{"Degraded", "False"}:      0,
+		{"Degraded", "Unknown"}:    1,
+	}
+
+	seen := map[[2]string]bool{}
+	for _, m := range family.Metrics {
+		key := [2]string{labelValue(m, "condition"), labelValue(m, "status")}
+		want, ok := wantValues[key]
+		if !ok {
+			t.Fatalf("unexpected condition/status combination %v", key)
+		}
+		if m.Value != want {
+			t.Errorf("condition %v: expected value %v, got %v", key, want, m.Value)
+		}
+		seen[key] = true
+	}
+
+	if len(seen) != len(wantValues) {
+		t.Errorf("expected every condition/status combination to be emitted exactly once, got %d of %d", len(seen), len(wantValues))
+	}
+}
+
+func TestStatefulSetStoreConditionLastTransitionTime(t *testing.T) {
+	transitionTime := metav1.NewTime(time.Unix(1500000000, 0))
+
+	s := &v1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "ss", Namespace: "ns"},
+		Status: v1.StatefulSetStatus{
+			Conditions: []v1.StatefulSetCondition{
+				{Type: "Ready", Status: corev1.ConditionTrue, LastTransitionTime: transitionTime},
+				{Type: "Progressing", Status: corev1.ConditionFalse},
+			},
+		},
+	}
+
+	family := generateFamily(t, "kube_statefulset_condition_last_transition_time", s)
+
+	if len(family.Metrics) != 1 {
+		t.Fatalf("expected 1 metric (condition with zero LastTransitionTime omitted), got %d", len(family.Metrics))
+	}
+
+	m := family.Metrics[0]
+	if got := labelValue(m, "condition"); got != "Ready" {
+		t.Errorf("expected condition label %q, got %q", "Ready", got)
+	}
+	if want := float64(transitionTime.Unix()); m.Value != want {
+		t.Errorf("expected value %v, got %v", want, m.Value)
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2017 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	"k8s.io/kube-state-metrics/v2/pkg/metric"
+)
+
+// conditionStatuses is the list of all condition statuses that can be returned
+var conditionStatuses = []v1.ConditionStatus{v1.ConditionTrue, v1.ConditionFalse, v1.ConditionUnknown}
+
+// addConditionMetrics generates one metric for each possible condition
+// status. For this function to work properly, the last label in the metric
+// description must be the condition status.
+func addConditionMetrics(cs v1.ConditionStatus) []*metric.Metric {
+	ms := make([]*metric.Metric, len(conditionStatuses))
+
+	for i, status := range conditionStatuses {
+		ms[i] = &metric.Metric{
+			LabelValues: []string{string(status)},
+			Value:       boolFloat64(cs == status),
+		}
+	}
+
+	return ms
+}
+
+// boolFloat64 converts a boolean to a float64, 1.0 for true and 0.0 for
+// false.
+func boolFloat64(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}